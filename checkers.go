@@ -0,0 +1,135 @@
+package vala
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Matches checks that s matches pattern in its entirety (the pattern
+// is implicitly anchored at both ends). pattern is compiled once, at
+// construction time; an invalid pattern produces a Checker that
+// always fails with the compile error rather than panicking.
+func Matches(s, pattern, paramName string, comment ...CommentInterface) Checker {
+	re, compileErr := regexp.Compile("^(?:" + pattern + ")$")
+
+	return func() (matches bool, err error) {
+		if compileErr != nil {
+			return false, addComment(newFailure(paramName, "Matches", s,
+				"Parameter %s: invalid pattern %q: %v", paramName, pattern, compileErr), comment)
+		}
+
+		matches = re.MatchString(s)
+		err = newFailure(paramName, "Matches", s,
+			"Parameter did not match pattern: %s(%q) !~ /%s/", paramName, s, pattern)
+		if !matches {
+			err = addComment(err, comment)
+		}
+		return matches, err
+	}
+}
+
+// DeepEquals checks that got and want are equal using
+// reflect.DeepEqual, and, unlike Equals, describes a mismatch as a
+// field-path diff (e.g. ".Addresses[0].Zip: got=\"\", want=\"12345\"")
+// rather than a bare %#v dump of both values.
+func DeepEquals(got, want interface{}, paramName string, comment ...CommentInterface) Checker {
+	return func() (equal bool, err error) {
+		equal = reflect.DeepEqual(got, want)
+		msg := fmt.Sprintf("Parameter %s deep-equaled expected value", paramName)
+		if !equal {
+			msg = fmt.Sprintf("Parameter %s did not deep-equal expected value:\n\t%s",
+				paramName, strings.Join(deepDiff(got, want, ""), "\n\t"))
+		}
+		err = newFailure(paramName, "DeepEquals", got, "%s", msg)
+		if !equal {
+			err = addComment(err, comment)
+		}
+		return equal, err
+	}
+}
+
+// JSONEquals checks that gotJSON, once unmarshaled, deep-equals want,
+// so that byte-level differences in key order or whitespace don't
+// cause a spurious failure. want may either be a Go value to marshal
+// for comparison, or a JSON-encoded []byte/string to unmarshal
+// directly.
+func JSONEquals(gotJSON []byte, want interface{}, paramName string, comment ...CommentInterface) Checker {
+	return func() (equal bool, err error) {
+		var gotVal interface{}
+		if jsonErr := json.Unmarshal(gotJSON, &gotVal); jsonErr != nil {
+			return false, addComment(newFailure(paramName, "JSONEquals", gotJSON,
+				"Parameter %s is not valid JSON: %v", paramName, jsonErr), comment)
+		}
+
+		var wantVal interface{}
+		switch w := want.(type) {
+		case []byte:
+			if jsonErr := json.Unmarshal(w, &wantVal); jsonErr != nil {
+				return false, addComment(newFailure(paramName, "JSONEquals", gotJSON,
+					"Parameter %s: want value is not valid JSON: %v", paramName, jsonErr), comment)
+			}
+		case string:
+			if jsonErr := json.Unmarshal([]byte(w), &wantVal); jsonErr != nil {
+				return false, addComment(newFailure(paramName, "JSONEquals", gotJSON,
+					"Parameter %s: want value is not valid JSON: %v", paramName, jsonErr), comment)
+			}
+		default:
+			wantJSON, marshalErr := json.Marshal(want)
+			if marshalErr != nil {
+				return false, addComment(newFailure(paramName, "JSONEquals", gotJSON,
+					"Parameter %s: want value could not be marshaled: %v", paramName, marshalErr), comment)
+			}
+			if jsonErr := json.Unmarshal(wantJSON, &wantVal); jsonErr != nil {
+				return false, addComment(newFailure(paramName, "JSONEquals", gotJSON,
+					"Parameter %s: want value is not valid JSON: %v", paramName, jsonErr), comment)
+			}
+		}
+
+		equal = reflect.DeepEqual(gotVal, wantVal)
+		err = newFailure(paramName, "JSONEquals", gotJSON,
+			"Parameter %s was not JSON-equal to expected value:\n\tgot:  %#v\n\twant: %#v",
+			paramName, gotVal, wantVal)
+		if !equal {
+			err = addComment(err, comment)
+		}
+		return equal, err
+	}
+}
+
+// Between checks that param falls within [low, high], inclusive.
+func Between(param, low, high int, paramName string, comment ...CommentInterface) Checker {
+	return func() (between bool, err error) {
+		between = param >= low && param <= high
+		err = newFailure(paramName, "Between", param,
+			"Parameter was not between bounds: %s(%d) not in [%d, %d]",
+			paramName, param, low, high)
+		if !between {
+			err = addComment(err, comment)
+		}
+		return between, err
+	}
+}
+
+// OneOf checks that param is deep-equal to at least one value in
+// allowed.
+func OneOf(param interface{}, allowed []interface{}, paramName string, comment ...CommentInterface) Checker {
+	return func() (found bool, err error) {
+		for _, a := range allowed {
+			if reflect.DeepEqual(param, a) {
+				found = true
+				break
+			}
+		}
+
+		err = newFailure(paramName, "OneOf", param,
+			"Parameter %s(%v) was not one of the allowed values: %v",
+			paramName, param, allowed)
+		if !found {
+			err = addComment(err, comment)
+		}
+		return found, err
+	}
+}
@@ -0,0 +1,207 @@
+package vala
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatches(t *testing.T) {
+	err := BeginValidation().Validate(
+		Matches("hello123", `[a-z]+\d+`, "tmpA"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		Matches("hello", `\d+`, "tmpB"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		Matches("hello", "(", "tmpC"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error for an invalid pattern.")
+		t.FailNow()
+	}
+}
+
+func TestDeepEquals(t *testing.T) {
+	err := BeginValidation().Validate(
+		DeepEquals([]int{1, 2, 3}, []int{1, 2, 3}, "tmpA"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		DeepEquals([]int{1, 2, 3}, []int{1, 2, 4}, "tmpB"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), "[2]: got=3, want=4") {
+		t.Errorf("Expected a field-path diff pinpointing the mismatched element, got: %v", err)
+	}
+}
+
+func TestDeepEqualsStructFieldPath(t *testing.T) {
+	type address struct{ Zip string }
+	type user struct{ Address address }
+
+	got := user{Address: address{Zip: ""}}
+	want := user{Address: address{Zip: "12345"}}
+
+	err := BeginValidation().Validate(
+		DeepEquals(got, want, "tmpA"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), `.Address.Zip: got="", want="12345"`) {
+		t.Errorf("Expected a struct field-path diff, got: %v", err)
+	}
+}
+
+// TestDeepEqualsUnexportedFields is a regression test for a panic:
+// time.Time carries unexported fields (wall, ext, loc), and reflect
+// refuses to read them via Interface(), so the field-path diff must
+// skip over them instead of crashing.
+func TestDeepEqualsUnexportedFields(t *testing.T) {
+	got := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	want := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	err := BeginValidation().Validate(
+		DeepEquals(got, want, "tmpA"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), "unexported field") {
+		t.Errorf("Expected the diff to note the unexported field rather than panicking, got: %v", err)
+	}
+}
+
+func TestJSONEquals(t *testing.T) {
+	err := BeginValidation().Validate(
+		JSONEquals([]byte(`{"a":1,"b":2}`), []byte(`{"b":2,"a":1}`), "tmpA"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		JSONEquals([]byte(`{"a":1}`), map[string]interface{}{"a": 1}, "tmpB"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		JSONEquals([]byte(`{"a":1}`), []byte(`{"a":2}`), "tmpC"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+}
+
+func TestBetween(t *testing.T) {
+	err := BeginValidation().Validate(
+		Between(5, 0, 10, "tmpA"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		Between(15, 0, 10, "tmpB"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	err := BeginValidation().Validate(
+		OneOf("b", []interface{}{"a", "b", "c"}, "tmpA"),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		OneOf("z", []interface{}{"a", "b", "c"}, "tmpB"),
+	).Check()
+
+	if err == nil {
+		t.Errorf("Expected an error.")
+		t.FailNow()
+	}
+
+	err = BeginValidation().Validate(
+		Not(OneOf("z", []interface{}{"a", "b", "c"}, "tmpC")),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+}
+
+// TestNotDescribesPassingChecker ensures the new checkers behave like
+// the legacy ones under Not: since Not's message embeds the wrapped
+// Checker's error even when that Checker passed, a passing Checker
+// must still describe what it checked instead of yielding a nil
+// error.
+func TestNotDescribesPassingChecker(t *testing.T) {
+	cases := []struct {
+		name    string
+		checker Checker
+		want    string
+	}{
+		{"Matches", Matches("abc", "abc", "x"), "did not match pattern"},
+		{"DeepEquals", DeepEquals(1, 1, "x"), "deep-equaled expected value"},
+		{"Between", Between(5, 0, 10, "x"), "was not between bounds"},
+		{"OneOf", OneOf("a", []interface{}{"a", "b"}, "x"), "was not one of the allowed values"},
+	}
+
+	for _, c := range cases {
+		err := BeginValidation().Validate(Not(c.checker)).Check()
+		if err == nil {
+			t.Errorf("%s: expected Not to fail on a passing checker", c.name)
+			continue
+		}
+		if strings.Contains(err.Error(), "<nil>") || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("%s: expected Not message to describe the check, got: %v", c.name, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package vala
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// deepDiff walks got and want in lockstep and returns one line per
+// leaf at which they differ, each prefixed with the field path (Go
+// selector/index syntax, e.g. ".Name" or "[2].Tags[0]") that leaf was
+// found at, relative to root. It underlies DeepEquals' failure
+// message so a mismatch points at what's actually wrong instead of
+// dumping both values whole. Unexported
+// struct fields (e.g. time.Time's internal fields) can't be read via
+// reflection, so they're reported by path only, without a got/want
+// value.
+func deepDiff(got, want interface{}, root string) []string {
+	return diffValue(reflect.ValueOf(got), reflect.ValueOf(want), root)
+}
+
+func diffValue(got, want reflect.Value, path string) []string {
+	if !got.IsValid() || !want.IsValid() {
+		if got.IsValid() != want.IsValid() {
+			return []string{fmt.Sprintf("%s: got=%s, want=%s", displayPath(path), displayValue(got), displayValue(want))}
+		}
+		return nil
+	}
+
+	if got.Type() != want.Type() {
+		return []string{fmt.Sprintf("%s: got=%s (%s), want=%s (%s)",
+			displayPath(path), displayValue(got), got.Type(), displayValue(want), want.Type())}
+	}
+
+	switch got.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if got.IsNil() || want.IsNil() {
+			if got.IsNil() != want.IsNil() {
+				return []string{fmt.Sprintf("%s: got=%s, want=%s", displayPath(path), displayValue(got), displayValue(want))}
+			}
+			return nil
+		}
+		return diffValue(got.Elem(), want.Elem(), path)
+
+	case reflect.Struct:
+		var diffs []string
+		for i := 0; i < got.NumField(); i++ {
+			gf, wf := got.Field(i), want.Field(i)
+			if !gf.CanInterface() {
+				// Unexported field (e.g. time.Time's wall/ext/loc): reflect
+				// forbids reading its value, so it can't be diffed or
+				// displayed. reflect.DeepEqual (used for the pass/fail
+				// decision) still sees it; just flag it as a possible
+				// culprit instead of panicking.
+				diffs = append(diffs, fmt.Sprintf("%s: unexported field, unable to diff", path+"."+got.Type().Field(i).Name))
+				continue
+			}
+			diffs = append(diffs, diffValue(gf, wf, path+"."+got.Type().Field(i).Name)...)
+		}
+		return diffs
+
+	case reflect.Map:
+		var diffs []string
+		for _, k := range got.MapKeys() {
+			keyPath := fmt.Sprintf("%s[%v]", path, k.Interface())
+			wv := want.MapIndex(k)
+			if !wv.IsValid() {
+				diffs = append(diffs, fmt.Sprintf("%s: got=%s, want=<missing>", keyPath, displayValue(got.MapIndex(k))))
+				continue
+			}
+			diffs = append(diffs, diffValue(got.MapIndex(k), wv, keyPath)...)
+		}
+		for _, k := range want.MapKeys() {
+			if !got.MapIndex(k).IsValid() {
+				diffs = append(diffs, fmt.Sprintf("%s[%v]: got=<missing>, want=%s", path, k.Interface(), displayValue(want.MapIndex(k))))
+			}
+		}
+		return diffs
+
+	case reflect.Slice, reflect.Array:
+		n := got.Len()
+		if want.Len() > n {
+			n = want.Len()
+		}
+		var diffs []string
+		for i := 0; i < n; i++ {
+			idxPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= got.Len():
+				diffs = append(diffs, fmt.Sprintf("%s: got=<missing>, want=%s", idxPath, displayValue(want.Index(i))))
+			case i >= want.Len():
+				diffs = append(diffs, fmt.Sprintf("%s: got=%s, want=<missing>", idxPath, displayValue(got.Index(i))))
+			default:
+				diffs = append(diffs, diffValue(got.Index(i), want.Index(i), idxPath)...)
+			}
+		}
+		return diffs
+
+	default:
+		if !got.CanInterface() || !want.CanInterface() {
+			return []string{fmt.Sprintf("%s: unexported field, unable to diff", displayPath(path))}
+		}
+		if reflect.DeepEqual(got.Interface(), want.Interface()) {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: got=%s, want=%s", displayPath(path), displayValue(got), displayValue(want))}
+	}
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+func displayValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<missing>"
+	}
+	if !v.CanInterface() {
+		return "<unexported>"
+	}
+	return fmt.Sprintf("%#v", v.Interface())
+}
@@ -0,0 +1,107 @@
+package vala
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Failure describes a single Checker's failure: which parameter and
+// checker produced it, the rendered message, the value that was
+// checked, and any additional context the checker chose to record
+// via the note callback documented on CheckerWithNotes. Built-in
+// checkers populate ParamName and CheckerName deterministically;
+// third-party Checkers that return a plain error are still collected,
+// just with only Message set.
+type Failure struct {
+	ParamName   string
+	CheckerName string
+	Message     string
+	Value       interface{}
+	Notes       map[string]interface{}
+}
+
+// Error implements error.
+func (f *Failure) Error() string {
+	return f.Message
+}
+
+// ValidationError aggregates the Failures produced by a single
+// Validate chain. It implements error, so code that only checks
+// `if err != nil` keeps working unchanged; callers that want
+// structured, per-field detail - e.g. to render `{"field": "reason"}`
+// from an HTTP handler - can recover it with errors.As(err, &ve)
+// instead of parsing the human-readable message.
+type ValidationError struct {
+	Failures []Failure
+}
+
+// Error implements error, producing the same human-readable format
+// vala has always returned.
+func (ve *ValidationError) Error() string {
+	if len(ve.Failures) == 1 {
+		return fmt.Sprintf("parameter validation failed: %s", ve.Failures[0].Message)
+	}
+
+	msgs := make([]string, 0, len(ve.Failures))
+	for _, f := range ve.Failures {
+		msgs = append(msgs, f.Message)
+	}
+	return fmt.Sprintf("parameter validation failed:\n\t%s", strings.Join(msgs, "\n\t"))
+}
+
+// CheckerWithNotes is a convention Checker authors - such as registry-
+// or Commentf-based extensions - can adopt to attach arbitrary
+// debugging context to a failure without constructing a Failure by
+// hand. note may be called any number of times while the check runs;
+// each call records its key/value pair in the Notes of the Failure
+// produced if f fails, a later call with the same key replacing the
+// earlier value. Use NewNotesChecker to adapt a CheckerWithNotes into a
+// Checker.
+type CheckerWithNotes func(note func(key string, value interface{})) (pass bool, err error)
+
+// NewNotesChecker adapts f into a Checker. If f fails, the notes it
+// recorded via its note callback are attached to the resulting
+// Failure's Notes.
+func NewNotesChecker(paramName, checkerName string, f CheckerWithNotes) Checker {
+	return func() (bool, error) {
+		notes := make(map[string]interface{})
+		pass, err := f(func(key string, value interface{}) {
+			notes[key] = value
+		})
+		if pass {
+			return true, err
+		}
+
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return false, &Failure{
+			ParamName:   paramName,
+			CheckerName: checkerName,
+			Message:     msg,
+			Notes:       notes,
+		}
+	}
+}
+
+// newFailure builds a *Failure for checkerName/paramName/value, with
+// Message formatted in the manner of fmt.Sprintf.
+func newFailure(paramName, checkerName string, value interface{}, format string, args ...interface{}) *Failure {
+	return &Failure{
+		ParamName:   paramName,
+		CheckerName: checkerName,
+		Message:     fmt.Sprintf(format, args...),
+		Value:       value,
+	}
+}
+
+// toFailure normalizes any error returned by a Checker into a
+// Failure. Built-in checkers already return a *Failure; a plain error
+// from a third-party Checker is wrapped with only Message populated.
+func toFailure(err error) Failure {
+	if f, ok := err.(*Failure); ok {
+		return *f
+	}
+	return Failure{Message: err.Error()}
+}
@@ -0,0 +1,64 @@
+package vala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationErrorAs(t *testing.T) {
+	err := BeginValidation().Validate(
+		IsNotNil(nil, "a"),
+		Equals("foo", "bar", "b"),
+	).Check()
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected errors.As to find a *ValidationError, got %T", err)
+	}
+
+	if len(ve.Failures) != 2 {
+		t.Fatalf("expected 2 failures, got %d", len(ve.Failures))
+	}
+
+	if ve.Failures[0].ParamName != "a" || ve.Failures[0].CheckerName != "IsNotNil" {
+		t.Errorf("unexpected failure metadata: %+v", ve.Failures[0])
+	}
+
+	if ve.Failures[1].ParamName != "b" || ve.Failures[1].CheckerName != "Equals" {
+		t.Errorf("unexpected failure metadata: %+v", ve.Failures[1])
+	}
+}
+
+func TestValidationErrorMessageUnchanged(t *testing.T) {
+	err := BeginValidation().Validate(
+		Equals("foo", "bar", "b"),
+	).Check()
+
+	want := "parameter validation failed: Parameters were not equal: foo, bar"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestNewNotesChecker(t *testing.T) {
+	checker := NewNotesChecker("x", "IsPositive", func(note func(string, interface{})) (bool, error) {
+		note("checked_value", -1)
+		return false, errors.New("value was not positive")
+	})
+
+	err := BeginValidation().Validate(checker).Check()
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected errors.As to find a *ValidationError, got %T", err)
+	}
+
+	f := ve.Failures[0]
+	if f.ParamName != "x" || f.CheckerName != "IsPositive" {
+		t.Errorf("unexpected failure metadata: %+v", f)
+	}
+
+	if f.Notes["checked_value"] != -1 {
+		t.Errorf("expected note to be recorded, got: %+v", f.Notes)
+	}
+}
@@ -0,0 +1,157 @@
+package registry
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kylemcc/vala"
+)
+
+// coerce converts arg to a value comparable to sample: an int if
+// sample is an int, a float64 if sample is a float64, a bool if
+// sample is a bool, and the raw string otherwise (including for other
+// numeric types such as int64 or float32).
+func coerce(arg string, sample interface{}) (interface{}, error) {
+	switch sample.(type) {
+	case int:
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("registry: %q is not an int: %v", arg, err)
+		}
+		return n, nil
+	case float64:
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("registry: %q is not a float64: %v", arg, err)
+		}
+		return f, nil
+	case bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return nil, fmt.Errorf("registry: %q is not a bool: %v", arg, err)
+		}
+		return b, nil
+	default:
+		return arg, nil
+	}
+}
+
+func equalsFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("registry: equals expects exactly 1 argument, got %d", len(args))
+	}
+
+	rhs, err := coerce(args[0], value)
+	if err != nil {
+		return nil, err
+	}
+
+	return vala.Equals(value, rhs, paramName), nil
+}
+
+func isNotNilFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("registry: is_not_nil takes no arguments, got %d", len(args))
+	}
+
+	return vala.IsNotNil(value, paramName), nil
+}
+
+func hasLenFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("registry: has_len expects exactly 1 argument, got %d", len(args))
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("registry: %q is not an int: %v", args[0], err)
+	}
+
+	return vala.HasLen(value, n, paramName), nil
+}
+
+func greaterThanFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("registry: greater_than expects exactly 1 argument, got %d", len(args))
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("registry: %q is not an int: %v", args[0], err)
+	}
+
+	param, ok := value.(int)
+	if !ok {
+		return nil, fmt.Errorf("registry: greater_than requires an int value, got %T", value)
+	}
+
+	return vala.GreaterThan(param, n, paramName), nil
+}
+
+func stringNotEmptyFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("registry: string_not_empty takes no arguments, got %d", len(args))
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("registry: string_not_empty requires a string value, got %T", value)
+	}
+
+	return vala.StringNotEmpty(s, paramName), nil
+}
+
+// branchCheckers parses each comma-separated sub-expression in args
+// against value/paramName and collapses each one down to a single
+// Checker via vala.And, so that or/and can recurse into nested filter
+// expressions such as or(has_len(5), string_not_empty).
+func branchCheckers(value interface{}, paramName string, args []string) ([]vala.Checker, error) {
+	branches := make([]vala.Checker, 0, len(args))
+	for _, arg := range args {
+		checkers, err := Parse(arg, value, paramName)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, vala.And(checkers...))
+	}
+	return branches, nil
+}
+
+func notFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("registry: not expects exactly 1 argument, got %d", len(args))
+	}
+
+	checkers, err := Parse(args[0], value, paramName)
+	if err != nil {
+		return nil, err
+	}
+
+	return vala.Not(vala.And(checkers...)), nil
+}
+
+func orFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("registry: or expects at least 2 arguments, got %d", len(args))
+	}
+
+	branches, err := branchCheckers(value, paramName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return vala.Or(branches...), nil
+}
+
+func andFactory(value interface{}, paramName string, args ...string) (vala.Checker, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("registry: and expects at least 2 arguments, got %d", len(args))
+	}
+
+	branches, err := branchCheckers(value, paramName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return vala.And(branches...), nil
+}
@@ -0,0 +1,143 @@
+// Package registry lets Checker factories be registered by name and
+// then assembled into validation chains from small textual
+// expressions sourced from config files, struct tags, or HTTP query
+// parameters, e.g.:
+//
+//	not_nil | string_not_empty | has_len(50)
+//
+// Expressions are a pipe-separated chain of filter calls. A filter
+// call is a registered name, optionally followed by a parenthesized,
+// comma-separated argument list, e.g. has_len(50). Names are matched
+// case-insensitively.
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kylemcc/vala"
+)
+
+// Factory builds a vala.Checker bound to value/paramName, using
+// whatever arguments were supplied in the filter call (e.g. the "50"
+// in has_len(50)). Factories are responsible for coercing those
+// arguments to whatever types they need.
+type Factory func(value interface{}, paramName string, args ...string) (vala.Checker, error)
+
+// ErrNoSuchValidator is returned by Parse when an expression names a
+// filter that was never registered, so that callers can distinguish a
+// malformed expression from a failed validation.
+var ErrNoSuchValidator = errors.New("registry: no such validator")
+
+var factories = map[string]Factory{}
+
+// Register associates name, matched case-insensitively by Parse, with
+// factory. Registering a name that is already registered overwrites
+// the previous factory.
+func Register(name string, factory Factory) {
+	factories[strings.ToLower(name)] = factory
+}
+
+func init() {
+	Register("equals", equalsFactory)
+	Register("is_not_nil", isNotNilFactory)
+	Register("not_nil", isNotNilFactory)
+	Register("has_len", hasLenFactory)
+	Register("greater_than", greaterThanFactory)
+	Register("string_not_empty", stringNotEmptyFactory)
+	Register("not", notFactory)
+	Register("or", orFactory)
+	Register("and", andFactory)
+}
+
+// Parse tokenizes expr into its pipe-separated filter calls, resolves
+// each by lowercased name against the registry, and returns the
+// resulting Checkers bound to value/paramName, ready to pass directly
+// to (*vala.Validation).Validate. Parse returns ErrNoSuchValidator,
+// wrapped with the offending name, if expr names a filter that was
+// never registered.
+func Parse(expr string, value interface{}, paramName string) ([]vala.Checker, error) {
+	calls, err := splitTopLevel(expr, '|')
+	if err != nil {
+		return nil, err
+	}
+
+	checkers := make([]vala.Checker, 0, len(calls))
+	for _, call := range calls {
+		checker, err := parseCall(call, value, paramName)
+		if err != nil {
+			return nil, err
+		}
+		checkers = append(checkers, checker)
+	}
+
+	return checkers, nil
+}
+
+// parseCall parses a single "name" or "name(arg, arg, ...)" filter
+// call and resolves it through the registry.
+func parseCall(call string, value interface{}, paramName string) (vala.Checker, error) {
+	call = strings.TrimSpace(call)
+
+	name := call
+	var argList string
+	if i := strings.IndexByte(call, '('); i >= 0 {
+		if call[len(call)-1] != ')' {
+			return nil, fmt.Errorf("registry: unbalanced parentheses in %q", call)
+		}
+		name = strings.TrimSpace(call[:i])
+		argList = call[i+1 : len(call)-1]
+	}
+
+	factory, ok := factories[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNoSuchValidator, name)
+	}
+
+	var args []string
+	if strings.TrimSpace(argList) != "" {
+		var err error
+		if args, err = splitTopLevel(argList, ','); err != nil {
+			return nil, err
+		}
+		for i, a := range args {
+			args[i] = strings.TrimSpace(a)
+		}
+	}
+
+	return factory(value, paramName, args...)
+}
+
+// splitTopLevel splits s on sep, ignoring any sep found inside a
+// parenthesized group, and trims whitespace from each piece.
+func splitTopLevel(s string, sep byte) ([]string, error) {
+	var (
+		pieces []string
+		depth  int
+		start  int
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("registry: unbalanced parentheses in %q", s)
+			}
+		case sep:
+			if depth == 0 {
+				pieces = append(pieces, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("registry: unbalanced parentheses in %q", s)
+	}
+
+	pieces = append(pieces, strings.TrimSpace(s[start:]))
+	return pieces, nil
+}
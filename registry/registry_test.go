@@ -0,0 +1,88 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kylemcc/vala"
+)
+
+func check(t *testing.T, checkers []vala.Checker, wantErr bool) {
+	t.Helper()
+
+	err := vala.BeginValidation().Validate(checkers...).Check()
+	if (err != nil) != wantErr {
+		t.Errorf("got err = %v, wantErr = %v", err, wantErr)
+	}
+}
+
+func TestParseSimpleChain(t *testing.T) {
+	checkers, err := Parse("is_not_nil | string_not_empty | has_len(5)", "hello", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, false)
+
+	checkers, err = Parse("is_not_nil | string_not_empty | has_len(5)", "too long", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, true)
+}
+
+func TestParseGreaterThan(t *testing.T) {
+	checkers, err := Parse("greater_than(0)", 5, "count")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, false)
+
+	checkers, err = Parse("greater_than(10)", 5, "count")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, true)
+}
+
+func TestParseNot(t *testing.T) {
+	checkers, err := Parse("not(string_not_empty)", "", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, false)
+
+	checkers, err = Parse("not(string_not_empty)", "hello", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, true)
+}
+
+func TestParseOr(t *testing.T) {
+	checkers, err := Parse("or(has_len(10), string_not_empty)", "hi", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, false)
+
+	checkers, err = Parse("or(has_len(10), string_not_empty)", "", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, true)
+}
+
+func TestParseNotNilAlias(t *testing.T) {
+	checkers, err := Parse("not_nil | string_not_empty | has_len(5)", "hello", "name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check(t, checkers, false)
+}
+
+func TestParseUnknownValidator(t *testing.T) {
+	_, err := Parse("not_a_real_filter", "hello", "name")
+	if !errors.Is(err, ErrNoSuchValidator) {
+		t.Fatalf("expected ErrNoSuchValidator, got %v", err)
+	}
+}
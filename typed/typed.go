@@ -0,0 +1,149 @@
+// Package typed provides a generics-based, type-safe validation API
+// that sits alongside vala's untyped Checker system. Where a Checker
+// closes over its arguments and loses them to interface{}, a
+// Validator[T] keeps the compile-time type of the value it checks,
+// and FromChecker bridges the two so the untyped and typed systems
+// compose.
+package typed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kylemcc/vala"
+)
+
+// Ordered is the set of types that support the <, <=, >, and >=
+// operators, mirroring golang.org/x/exp/constraints.Ordered without
+// pulling in the extra dependency.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Validator validates a value of type T, returning a descriptive
+// error if it is invalid.
+type Validator[T any] interface {
+	Validate(T) error
+}
+
+// ValidatorFunc adapts a plain function to the Validator interface.
+type ValidatorFunc[T any] func(T) error
+
+// Validate implements Validator.
+func (f ValidatorFunc[T]) Validate(v T) error {
+	return f(v)
+}
+
+// Chain runs a sequence of Validators against a single value, in
+// order, stopping and returning the first error encountered.
+type Chain[T any] []Validator[T]
+
+// Validate implements Validator.
+func (c Chain[T]) Validate(v T) error {
+	for _, validator := range c {
+		if err := validator.Validate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChainAll runs every Validator against v and aggregates all of the
+// resulting errors, mirroring the collect-all behavior of
+// (*vala.Validation).Validate, rather than stopping at the first
+// failure like Chain does.
+type ChainAll[T any] []Validator[T]
+
+// Validate implements Validator.
+func (c ChainAll[T]) Validate(v T) error {
+	errs := make([]string, 0, len(c))
+	for _, validator := range c {
+		if err := validator.Validate(v); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("parameter validation failed: %s", errs[0])
+	default:
+		return fmt.Errorf("parameter validation failed:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+}
+
+// NotNil checks that a pointer is not nil.
+func NotNil[T any]() Validator[*T] {
+	return ValidatorFunc[*T](func(v *T) error {
+		if v == nil {
+			return fmt.Errorf("parameter was nil")
+		}
+		return nil
+	})
+}
+
+// NotEmpty checks that a string is not empty.
+func NotEmpty() Validator[string] {
+	return ValidatorFunc[string](func(v string) error {
+		if v == "" {
+			return fmt.Errorf("parameter is an empty string")
+		}
+		return nil
+	})
+}
+
+// Len checks that a slice has exactly n elements.
+func Len[T any](n int) Validator[[]T] {
+	return ValidatorFunc[[]T](func(v []T) error {
+		if len(v) != n {
+			return fmt.Errorf("parameter did not contain the correct number of elements: want %d, got %d", n, len(v))
+		}
+		return nil
+	})
+}
+
+// Greater checks that a value is strictly greater than min.
+func Greater[T Ordered](min T) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		if !(v > min) {
+			return fmt.Errorf("parameter was not greater than %v: got %v", min, v)
+		}
+		return nil
+	})
+}
+
+// FieldChain adapts access, a struct-field accessor, and validators
+// over that field's type into a single Validator[T], so a field
+// validation can sit alongside whole-value Validators in the same
+// Chain, e.g.:
+//
+//	Chain[*User]{NotNil[User](), FieldChain(func(u *User) string { return u.Name }, NotEmpty())}
+//
+// T and F are both inferred from access, so callers don't need to
+// spell out either type parameter. Because access re-reads the field
+// from whatever value Validate is called with, rather than capturing
+// it once up front, the returned Validator can be built once and
+// reused across many values.
+func FieldChain[T, F any](access func(T) F, validators ...Validator[F]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		return Chain[F](validators).Validate(access(v))
+	})
+}
+
+// FromChecker adapts an existing, untyped vala.Checker constructor
+// into a Validator[T], so the typed and legacy systems can be
+// composed, e.g.:
+//
+//	FromChecker(func(s string) vala.Checker { return vala.StringNotEmpty(s, "name") })
+func FromChecker[T any](f func(T) vala.Checker) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		pass, err := f(v)()
+		if !pass {
+			return err
+		}
+		return nil
+	})
+}
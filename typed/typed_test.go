@@ -0,0 +1,102 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/kylemcc/vala"
+)
+
+func TestChain(t *testing.T) {
+	chain := Chain[[]int]{Len[int](3)}
+
+	if err := chain.Validate([]int{1, 2, 3}); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+
+	if err := chain.Validate(nil); err == nil {
+		t.Errorf("Expected an error.")
+	}
+}
+
+func TestChainAll(t *testing.T) {
+	chain := ChainAll[string]{NotEmpty(), FromChecker(func(s string) vala.Checker {
+		return vala.HasLen(s, 3, "s")
+	})}
+
+	if err := chain.Validate("abcd"); err == nil {
+		t.Errorf("Expected an error.")
+	} else if err.Error() == "" {
+		t.Errorf("Expected a non-empty aggregated error message.")
+	}
+
+	if err := chain.Validate("abc"); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+
+	if err := chain.Validate(""); err == nil {
+		t.Errorf("Expected an error that aggregates both failures.")
+	}
+}
+
+func TestNotNil(t *testing.T) {
+	validator := NotNil[int]()
+
+	n := 5
+	if err := validator.Validate(&n); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+
+	if err := validator.Validate(nil); err == nil {
+		t.Errorf("Expected an error.")
+	}
+}
+
+func TestGreater(t *testing.T) {
+	validator := Greater(0)
+
+	if err := validator.Validate(1); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+
+	if err := validator.Validate(0); err == nil {
+		t.Errorf("Expected an error.")
+	}
+}
+
+func TestFromChecker(t *testing.T) {
+	validator := FromChecker(func(s string) vala.Checker {
+		return vala.StringNotEmpty(s, "name")
+	})
+
+	if err := validator.Validate("hi"); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+
+	if err := validator.Validate(""); err == nil {
+		t.Errorf("Expected an error.")
+	}
+}
+
+func TestFieldChain(t *testing.T) {
+	type user struct {
+		Name string
+	}
+
+	// Built once, with T and F both inferred from the accessor func.
+	validator := Chain[*user]{
+		NotNil[user](),
+		FieldChain(func(u *user) string { return u.Name }, NotEmpty()),
+	}
+
+	u := &user{Name: ""}
+	if err := validator.Validate(u); err == nil {
+		t.Errorf("Expected an error.")
+	}
+
+	// The same validator, reused against a different value, must
+	// re-read the field rather than validate a stale snapshot.
+	u.Name = "vala"
+	if err := validator.Validate(u); err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+}
@@ -65,6 +65,7 @@ specification, you can pass it into the Validate method:
 package vala
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -138,18 +139,11 @@ func (val *Validation) Validate(checkers ...Checker) *Validation {
 }
 
 func (val *Validation) constructErrorMessage() error {
-	if len(val.Errors) == 1 {
-		return fmt.Errorf("parameter validation failed: %s", val.Errors[0])
-	}
-
-	errorStrings := make([]string, 0, len(val.Errors))
+	failures := make([]Failure, 0, len(val.Errors))
 	for _, e := range val.Errors {
-		errorStrings = append(errorStrings, e.Error())
+		failures = append(failures, toFailure(e))
 	}
-	return fmt.Errorf(
-		"parameter validation failed:\n\t%s",
-		strings.Join(errorStrings, "\n\t"),
-	)
+	return &ValidationError{Failures: failures}
 }
 
 //
@@ -162,12 +156,77 @@ func (val *Validation) constructErrorMessage() error {
 // returns an error message. This helps to support the Not function.
 type Checker func() (checkerIsTrue bool, err error)
 
+// CommentInterface is implemented by values which can attach an
+// additional, lazily-rendered message to a Checker's failure. Use
+// Commentf to build one.
+type CommentInterface interface {
+	// CheckCommentString renders the comment. It is only called
+	// when the Checker it is attached to fails, so the formatting
+	// cost is never paid on the happy path.
+	CheckCommentString() string
+}
+
+// comment is the CommentInterface returned by Commentf. The format
+// and args are stored unevaluated until CheckCommentString is called.
+type comment struct {
+	format string
+	args   []interface{}
+}
+
+// CheckCommentString implements CommentInterface.
+func (c comment) CheckCommentString() string {
+	return fmt.Sprintf(c.format, c.args...)
+}
+
+// Commentf annotates a Checker call site with a message, formatted in
+// the manner of fmt.Sprintf, that is appended to the failure message
+// if, and only if, the Checker fails. This lets callers identify
+// which iteration of a loop or row of a table-driven test produced a
+// given failure without paying to build the string when it passes,
+// e.g. Equals(a, b, "a", Commentf("iteration #%d", i)).
+func Commentf(format string, args ...interface{}) CommentInterface {
+	return comment{format, args}
+}
+
+// addComment appends the rendered comments, if any, to err, in the
+// order they were supplied. It is a no-op when err is nil or no
+// comment was supplied. If err is a *Failure, the comments are folded
+// into its Message so ParamName, CheckerName, and Value survive.
+func addComment(err error, comments []CommentInterface) error {
+	if err == nil || len(comments) == 0 {
+		return err
+	}
+
+	rendered := make([]string, len(comments))
+	for i, c := range comments {
+		rendered[i] = c.CheckCommentString()
+	}
+	msg := fmt.Sprintf("%s (%s)", err, strings.Join(rendered, "; "))
+
+	if f, ok := err.(*Failure); ok {
+		annotated := *f
+		annotated.Message = msg
+		return &annotated
+	}
+
+	return errors.New(msg)
+}
+
 // Not returns the inverse of any Checker passed in.
-func Not(checker Checker) Checker {
+func Not(checker Checker, comment ...CommentInterface) Checker {
 
 	return func() (passed bool, err error) {
 		if passed, err = checker(); passed {
-			return false, fmt.Errorf("Not(%s)", err)
+			var paramName string
+			if f, ok := err.(*Failure); ok {
+				paramName = f.ParamName
+			}
+
+			return false, addComment(&Failure{
+				ParamName:   paramName,
+				CheckerName: "Not",
+				Message:     fmt.Sprintf("Not(%s)", err),
+			}, comment)
 		}
 
 		return true, nil
@@ -176,17 +235,27 @@ func Not(checker Checker) Checker {
 
 // Equals performs a basic == on the given parameters and fails if
 // they are not equal.
-func Equals(lhs, rhs interface{}, paramName string) Checker {
+func Equals(lhs, rhs interface{}, paramName string, comment ...CommentInterface) Checker {
 
 	return func() (pass bool, err error) {
-		return (lhs == rhs), fmt.Errorf("Parameters were not equal: %v, %v", lhs, rhs)
+		pass = lhs == rhs
+		err = &Failure{
+			ParamName:   paramName,
+			CheckerName: "Equals",
+			Message:     fmt.Sprintf("Parameters were not equal: %v, %v", lhs, rhs),
+			Value:       lhs,
+		}
+		if !pass {
+			err = addComment(err, comment)
+		}
+		return pass, err
 	}
 }
 
 // IsNotNil checks to see if the value passed in is nil. This Checker
 // attempts to check the most performant things first, and then
 // degrade into the less-performant, but accurate checks for nil.
-func IsNotNil(obtained interface{}, paramName string) Checker {
+func IsNotNil(obtained interface{}, paramName string, comment ...CommentInterface) Checker {
 	return func() (isNotNil bool, err error) {
 
 		if obtained == nil {
@@ -208,30 +277,53 @@ func IsNotNil(obtained interface{}, paramName string) Checker {
 			}
 		}
 
-		return isNotNil, fmt.Errorf("Parameter was nil: %v", paramName)
+		err = &Failure{
+			ParamName:   paramName,
+			CheckerName: "IsNotNil",
+			Message:     fmt.Sprintf("Parameter was nil: %v", paramName),
+			Value:       obtained,
+		}
+		if !isNotNil {
+			err = addComment(err, comment)
+		}
+		return isNotNil, err
 	}
 }
 
 // HasLen checks to ensure the given argument is the desired length.
-func HasLen(param interface{}, desiredLength int, paramName string) Checker {
+func HasLen(param interface{}, desiredLength int, paramName string, comment ...CommentInterface) Checker {
 
 	return func() (hasLen bool, err error) {
 		hasLen = desiredLength == reflect.ValueOf(param).Len()
-		return hasLen, fmt.Errorf("Parameter did not contain the correct number of elements: %v", paramName)
+		err = &Failure{
+			ParamName:   paramName,
+			CheckerName: "HasLen",
+			Message:     fmt.Sprintf("Parameter did not contain the correct number of elements: %v", paramName),
+			Value:       param,
+		}
+		if !hasLen {
+			err = addComment(err, comment)
+		}
+		return hasLen, err
 	}
 }
 
 // GreaterThan checks to ensure the given argument is greater than the
 // given value.
-func GreaterThan(param int, comparativeVal int, paramName string) Checker {
+func GreaterThan(param int, comparativeVal int, paramName string, comment ...CommentInterface) Checker {
 
 	return func() (isGreaterThan bool, err error) {
 		if isGreaterThan = param > comparativeVal; !isGreaterThan {
-			err = fmt.Errorf(
-				"Parameter's length was not greater than:  %s(%d) < %d",
-				paramName,
-				param,
-				comparativeVal)
+			err = addComment(&Failure{
+				ParamName:   paramName,
+				CheckerName: "GreaterThan",
+				Message: fmt.Sprintf(
+					"Parameter's length was not greater than:  %s(%d) < %d",
+					paramName,
+					param,
+					comparativeVal),
+				Value: param,
+			}, comment)
 		}
 
 		return isGreaterThan, err
@@ -239,16 +331,34 @@ func GreaterThan(param int, comparativeVal int, paramName string) Checker {
 }
 
 // StringNotEmpty checks to ensure the given string is not empty.
-func StringNotEmpty(obtained, paramName string) Checker {
+func StringNotEmpty(obtained, paramName string, comment ...CommentInterface) Checker {
 	return func() (isNotEmpty bool, err error) {
 		isNotEmpty = obtained != ""
-		err = fmt.Errorf("Parameter is an empty string: %s", paramName)
+		err = &Failure{
+			ParamName:   paramName,
+			CheckerName: "StringNotEmpty",
+			Message:     fmt.Sprintf("Parameter is an empty string: %s", paramName),
+			Value:       obtained,
+		}
+		if !isNotEmpty {
+			err = addComment(err, comment)
+		}
 		return
 	}
 }
 
 // Or executes multiple Checkers and makes sure one is valid
 func Or(checkers ...Checker) Checker {
+	return OrC(nil, checkers...)
+}
+
+// OrC behaves like Or, but also accepts a Commentf comment that is
+// appended to the aggregate failure message if every Checker fails.
+// It exists as a separate function, rather than an additional
+// trailing parameter on Or, because checkers is already variadic and
+// Go permits only one variadic parameter per function; pass nil for
+// comment to get Or's exact behavior.
+func OrC(comment CommentInterface, checkers ...Checker) Checker {
 	return func() (valid bool, err error) {
 		msgs := make([]string, 0, len(checkers))
 		for _, c := range checkers {
@@ -258,18 +368,38 @@ func Or(checkers ...Checker) Checker {
 			}
 			msgs = append(msgs, e.Error())
 		}
-		return false, fmt.Errorf("all checks failed:\n\t%s", strings.Join(msgs, "\n\t"))
+		return false, addComment(&Failure{
+			CheckerName: "Or",
+			Message:     fmt.Sprintf("all checks failed:\n\t%s", strings.Join(msgs, "\n\t")),
+		}, commentSlice(comment))
 	}
 }
 
 // And executes multiple Checkers and makes sure all are valid
 func And(checkers ...Checker) Checker {
+	return AndC(nil, checkers...)
+}
+
+// AndC behaves like And, but also accepts a Commentf comment that is
+// appended to the first failing Checker's message. See OrC for why
+// this is a separate function rather than a trailing parameter on
+// And; pass nil for comment to get And's exact behavior.
+func AndC(comment CommentInterface, checkers ...Checker) Checker {
 	return func() (valid bool, err error) {
 		for _, checker := range checkers {
 			if pass, err := checker(); !pass {
-				return false, err
+				return false, addComment(err, commentSlice(comment))
 			}
 		}
 		return true, nil
 	}
 }
+
+// commentSlice wraps a single, possibly-nil CommentInterface into the
+// []CommentInterface addComment expects.
+func commentSlice(comment CommentInterface) []CommentInterface {
+	if comment == nil {
+		return nil
+	}
+	return []CommentInterface{comment}
+}
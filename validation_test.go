@@ -1,6 +1,7 @@
 package vala
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -168,6 +169,36 @@ func TestStringNotEmpty(t *testing.T) {
 	}
 }
 
+func TestCommentf(t *testing.T) {
+
+	err := BeginValidation().Validate(
+		Equals("foo", "bar", "foo", Commentf("iteration #%d", 3)),
+	).Check()
+
+	if err == nil || !strings.Contains(err.Error(), "iteration #3") {
+		t.Errorf("Expected error to contain rendered comment, got: %v", err)
+	}
+
+	err = BeginValidation().Validate(
+		Equals("foo", "foo", "foo", Commentf("iteration #%d", 3)),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+		t.FailNow()
+	}
+}
+
+func TestCommentfMultiple(t *testing.T) {
+	err := BeginValidation().Validate(
+		Equals("foo", "bar", "foo", Commentf("row #%d", 3), Commentf("col #%d", 7)),
+	).Check()
+
+	if err == nil || !strings.Contains(err.Error(), "row #3") || !strings.Contains(err.Error(), "col #7") {
+		t.Errorf("Expected error to contain all rendered comments, got: %v", err)
+	}
+}
+
 func TestOr(t *testing.T) {
 	cases := []struct {
 		checkers []Checker
@@ -203,3 +234,30 @@ func TestAnd(t *testing.T) {
 		}
 	}
 }
+
+func TestOrCAndAndC(t *testing.T) {
+	err := BeginValidation().Validate(
+		OrC(Commentf("iteration #%d", 1), HasLen("short", 10, "test"), StringNotEmpty("", "test")),
+	).Check()
+
+	if err == nil || !strings.Contains(err.Error(), "iteration #1") {
+		t.Errorf("Expected OrC's comment in the error, got: %v", err)
+	}
+
+	err = BeginValidation().Validate(
+		AndC(Commentf("iteration #%d", 2), HasLen("short", 10, "test")),
+	).Check()
+
+	if err == nil || !strings.Contains(err.Error(), "iteration #2") {
+		t.Errorf("Expected AndC's comment in the error, got: %v", err)
+	}
+
+	// Passing nil for comment must behave exactly like Or/And.
+	err = BeginValidation().Validate(
+		OrC(nil, HasLen("1234567890", 10, "test")),
+	).Check()
+
+	if err != nil {
+		t.Errorf("Received an unexpected error: %v", err)
+	}
+}